@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/Cod-e-Codes/voicelog/backendpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ============================================================================
+// GRPC PROVIDER (External Backend Process)
+// ============================================================================
+
+// GRPCProvider talks to a transcription backend over gRPC instead of
+// re-executing a command-line tool per memo. The backend is either a
+// long-lived server at a configured address, or an executable dropped
+// into the providers/ directory that VoiceLog spawns on demand and
+// connects to over a UNIX socket.
+type GRPCProvider struct {
+	name       string
+	execPath   string
+	address    string
+	socketPath string
+	modelPath  string
+	language   string
+
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+	client backendpb.BackendClient
+}
+
+// NewGRPCProvider creates a provider for an executable found at execPath
+// inside the providers/ directory. name is the file's base name, used to
+// identify the provider in configuration and the TUI.
+func NewGRPCProvider(name, execPath string) *GRPCProvider {
+	return &GRPCProvider{
+		name:     name,
+		execPath: execPath,
+		language: "en",
+	}
+}
+
+func (g *GRPCProvider) Name() string {
+	return g.name
+}
+
+func (g *GRPCProvider) IsAvailable() bool {
+	if g.address != "" {
+		return true
+	}
+	if g.execPath == "" {
+		return false
+	}
+	_, err := os.Stat(g.execPath)
+	return err == nil
+}
+
+func (g *GRPCProvider) Configure(config map[string]string) error {
+	if addr, ok := config["address"]; ok {
+		g.address = addr
+	}
+	if path, ok := config["exec_path"]; ok {
+		g.execPath = path
+	}
+	if path, ok := config["model_path"]; ok {
+		g.modelPath = path
+	}
+	if lang, ok := config["language"]; ok {
+		g.language = lang
+	}
+	return nil
+}
+
+// ensureConnected dials an already-running backend at g.address, or spawns
+// g.execPath and connects over a UNIX socket, health-checking it until it
+// reports ready.
+func (g *GRPCProvider) ensureConnected() error {
+	if g.client != nil {
+		return nil
+	}
+
+	target := g.address
+	if target == "" {
+		if g.execPath == "" {
+			return fmt.Errorf("%s: no address or exec_path configured", g.name)
+		}
+
+		socketPath := filepath.Join(os.TempDir(), fmt.Sprintf("voicelog-%s.sock", g.name))
+		os.Remove(socketPath)
+
+		cmd := exec.Command(g.execPath, "--socket", socketPath)
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("failed to spawn %s: %v", g.execPath, err)
+		}
+
+		g.cmd = cmd
+		g.socketPath = socketPath
+		target = "unix://" + socketPath
+	}
+
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %v", g.name, err)
+	}
+
+	g.conn = conn
+	g.client = backendpb.NewBackendClient(conn)
+
+	if err := g.waitForHealthy(10 * time.Second); err != nil {
+		g.Close()
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	if _, err := g.client.Load(ctx, &backendpb.ModelOptions{
+		ModelPath: g.modelPath,
+		Language:  g.language,
+	}); err != nil {
+		g.Close()
+		return fmt.Errorf("%s: Load failed: %v", g.name, err)
+	}
+
+	return nil
+}
+
+// waitForHealthy polls Health until the backend reports ready or the
+// timeout elapses. Needed when we just spawned the process ourselves and
+// it hasn't finished starting up yet.
+func (g *GRPCProvider) waitForHealthy(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		result, err := g.client.Health(ctx, &backendpb.HealthRequest{})
+		cancel()
+		if err == nil && result.Ready {
+			return nil
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+	return fmt.Errorf("%s: backend did not become healthy within %s", g.name, timeout)
+}
+
+func (g *GRPCProvider) Transcribe(audioPath string) (string, error) {
+	result, err := g.TranscribeWithOptions(audioPath, TranscribeOptions{})
+	if err != nil {
+		return "", err
+	}
+	return result.Text, nil
+}
+
+// TranscribeWithOptions forwards Translate and WordTimestamps straight
+// through to the backend's AudioTranscription RPC.
+func (g *GRPCProvider) TranscribeWithOptions(audioPath string, opts TranscribeOptions) (*TranscriptionResult, error) {
+	if err := g.ensureConnected(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	result, err := g.client.AudioTranscription(ctx, &backendpb.TranscriptRequest{
+		AudioPath:      audioPath,
+		Translate:      opts.Translate,
+		WordTimestamps: opts.WordTimestamps,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: transcription failed: %v", g.name, err)
+	}
+
+	var segments []Segment
+	for _, seg := range result.Segments {
+		segments = append(segments, Segment{
+			Start:      seg.Start,
+			End:        seg.End,
+			Text:       seg.Text,
+			Confidence: seg.Confidence,
+		})
+	}
+
+	return &TranscriptionResult{
+		Text:       result.Text,
+		Language:   result.Language,
+		Confidence: result.Confidence,
+		Segments:   segments,
+		Translated: opts.Translate,
+	}, nil
+}
+
+// Close tears down the gRPC connection and, if we spawned the backend
+// ourselves, terminates the child process.
+func (g *GRPCProvider) Close() error {
+	if g.conn != nil {
+		g.conn.Close()
+		g.conn = nil
+	}
+	g.client = nil
+
+	if g.cmd != nil && g.cmd.Process != nil {
+		g.cmd.Process.Kill()
+		g.cmd.Wait()
+		g.cmd = nil
+	}
+
+	if g.socketPath != "" {
+		os.Remove(g.socketPath)
+		g.socketPath = ""
+	}
+
+	return nil
+}
+
+// DiscoverGRPCProviders scans dir (typically the providers/ directory
+// inside VoiceLog's config directory) for executables and returns a GRPCProvider for
+// each one found. Discovery does not spawn or dial anything - that
+// happens lazily on first Transcribe call.
+func DiscoverGRPCProviders(dir string) []*GRPCProvider {
+	var providers []*GRPCProvider
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return providers
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0111 == 0 {
+			continue
+		}
+
+		name := entry.Name()
+		execPath := filepath.Join(dir, name)
+		providers = append(providers, NewGRPCProvider(name, execPath))
+	}
+
+	return providers
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ============================================================================
+// STREAMING TRANSCRIPTION
+// ============================================================================
+
+const (
+	streamSampleRate = 16000
+
+	// streamSilenceDuration of near-silence before a segment is cut and sent
+	// for transcription.
+	streamSilenceDuration = 500 * time.Millisecond
+
+	// streamSilenceEnergyThreshold below which a frame is considered silence.
+	// PCM16 samples range [-32768, 32767]; this is a conservative floor
+	// tuned for close-mic speech over background hiss.
+	streamSilenceEnergyThreshold = 300
+
+	// streamPartialInterval is how often the in-progress segment is re-run
+	// through whisper.cpp so the TUI can show text appearing while the
+	// user is still speaking, mirroring whisper.cpp's own streaming
+	// example re-decoding a growing buffer.
+	streamPartialInterval = 700 * time.Millisecond
+)
+
+// PartialResult is one increment of a streaming transcription. Final
+// results mark the end of a VAD-delimited segment; non-final results let
+// the TUI show text appearing while the user is still speaking.
+type PartialResult struct {
+	Text    string
+	Final   bool
+	Segment int
+	Err     error
+}
+
+// StreamingTranscriptionProvider is implemented by providers that can
+// transcribe audio incrementally as it arrives, instead of requiring a
+// finished file on disk. audio delivers 16kHz mono PCM frames; the
+// returned channel is closed once audio is drained and the final
+// segment has been transcribed.
+type StreamingTranscriptionProvider interface {
+	Name() string
+	IsAvailable() bool
+	Configure(config map[string]string) error
+	TranscribeStream(ctx context.Context, audio <-chan []int16) <-chan PartialResult
+}
+
+// ============================================================================
+// WHISPER STREAMING PROVIDER (VAD-chunked whisper.cpp)
+// ============================================================================
+
+// WhisperStreamProvider cuts incoming PCM into segments using simple
+// energy-based VAD and transcribes each finished segment with an
+// underlying WhisperCppProvider. This threads audio frames out of the
+// recorder into the transcription layer instead of the file-in/text-out
+// model the other providers use.
+type WhisperStreamProvider struct {
+	whisper *WhisperCppProvider
+	tempDir string
+}
+
+func NewWhisperStreamProvider() *WhisperStreamProvider {
+	return &WhisperStreamProvider{
+		whisper: NewWhisperCppProvider(),
+		tempDir: os.TempDir(),
+	}
+}
+
+func (w *WhisperStreamProvider) Name() string {
+	return "whisper_stream"
+}
+
+func (w *WhisperStreamProvider) IsAvailable() bool {
+	return w.whisper.IsAvailable()
+}
+
+func (w *WhisperStreamProvider) Configure(config map[string]string) error {
+	return w.whisper.Configure(config)
+}
+
+// TranscribeStream accumulates frames from audio until streamSilenceDuration
+// of near-silence is observed, writes the accumulated segment to a WAV
+// file, and transcribes it with the underlying whisper.cpp provider. While
+// the segment is still growing, it also re-transcribes the in-progress
+// buffer every streamPartialInterval and emits the result as a non-final
+// PartialResult, so the caller sees text appear while the user is still
+// speaking instead of only once silence is detected. It finalizes the
+// in-progress segment when audio is closed or ctx is done.
+func (w *WhisperStreamProvider) TranscribeStream(ctx context.Context, audio <-chan []int16) <-chan PartialResult {
+	results := make(chan PartialResult)
+
+	go func() {
+		defer close(results)
+
+		var segment []int16
+		var silenceSince time.Time
+		var lastPartialAt time.Time
+		segmentIndex := 0
+
+		emit := func(result PartialResult) bool {
+			select {
+			case results <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		flush := func() {
+			if len(segment) == 0 {
+				return
+			}
+
+			segIdx := segmentIndex
+			segmentIndex++
+			samples := segment
+			segment = nil
+			lastPartialAt = time.Time{}
+
+			text, err := w.transcribeSegment(samples)
+			emit(PartialResult{Text: text, Final: true, Segment: segIdx, Err: err})
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				flush()
+				return
+
+			case frame, ok := <-audio:
+				if !ok {
+					flush()
+					return
+				}
+
+				segment = append(segment, frame...)
+				silent := isSilentFrame(frame)
+
+				if silent {
+					if silenceSince.IsZero() {
+						silenceSince = time.Now()
+					} else if time.Since(silenceSince) >= streamSilenceDuration {
+						flush()
+						silenceSince = time.Time{}
+						continue
+					}
+				} else {
+					silenceSince = time.Time{}
+				}
+
+				if !silent && time.Since(lastPartialAt) >= streamPartialInterval {
+					lastPartialAt = time.Now()
+					inProgress := append([]int16(nil), segment...)
+					text, err := w.transcribeSegment(inProgress)
+					if !emit(PartialResult{Text: text, Final: false, Segment: segmentIndex, Err: err}) {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return results
+}
+
+func (w *WhisperStreamProvider) transcribeSegment(samples []int16) (string, error) {
+	wavPath := filepath.Join(w.tempDir, fmt.Sprintf("voicelog_stream_%d.wav", time.Now().UnixNano()))
+	if err := writeWAV(wavPath, samples, streamSampleRate); err != nil {
+		return "", fmt.Errorf("failed to write segment audio: %v", err)
+	}
+	defer os.Remove(wavPath)
+
+	return w.whisper.Transcribe(wavPath)
+}
+
+// isSilentFrame reports whether frame's average absolute amplitude falls
+// below streamSilenceEnergyThreshold.
+func isSilentFrame(frame []int16) bool {
+	if len(frame) == 0 {
+		return true
+	}
+
+	var sum int64
+	for _, sample := range frame {
+		if sample < 0 {
+			sum -= int64(sample)
+		} else {
+			sum += int64(sample)
+		}
+	}
+
+	average := sum / int64(len(frame))
+	return average < streamSilenceEnergyThreshold
+}
+
+// writeWAV writes mono 16-bit PCM samples to path as a standard WAV file.
+func writeWAV(path string, samples []int16, sampleRate int) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	const (
+		numChannels   = 1
+		bitsPerSample = 16
+	)
+	byteRate := sampleRate * numChannels * bitsPerSample / 8
+	blockAlign := numChannels * bitsPerSample / 8
+	dataSize := len(samples) * 2
+
+	header := make([]byte, 44)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(36+dataSize))
+	copy(header[8:12], "WAVE")
+	copy(header[12:16], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:20], 16)
+	binary.LittleEndian.PutUint16(header[20:22], 1) // PCM
+	binary.LittleEndian.PutUint16(header[22:24], numChannels)
+	binary.LittleEndian.PutUint32(header[24:28], uint32(sampleRate))
+	binary.LittleEndian.PutUint32(header[28:32], uint32(byteRate))
+	binary.LittleEndian.PutUint16(header[32:34], uint16(blockAlign))
+	binary.LittleEndian.PutUint16(header[34:36], bitsPerSample)
+	copy(header[36:40], "data")
+	binary.LittleEndian.PutUint32(header[40:44], uint32(dataSize))
+
+	if _, err := f.Write(header); err != nil {
+		return err
+	}
+
+	data := make([]byte, dataSize)
+	for i, sample := range samples {
+		binary.LittleEndian.PutUint16(data[i*2:i*2+2], uint16(sample))
+	}
+
+	_, err = f.Write(data)
+	return err
+}
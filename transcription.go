@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -28,12 +29,42 @@ type TranscriptionConfig struct {
 
 // TranscriptionResult holds the transcription output
 type TranscriptionResult struct {
-	MemoID        string  `json:"memo_id"`
-	Text          string  `json:"text"`
-	Provider      string  `json:"provider"`
-	Confidence    float64 `json:"confidence,omitempty"`
-	Language      string  `json:"language,omitempty"`
-	TranscribedAt string  `json:"transcribed_at"`
+	MemoID        string    `json:"memo_id"`
+	Text          string    `json:"text"`
+	Provider      string    `json:"provider"`
+	Confidence    float64   `json:"confidence,omitempty"`
+	Language      string    `json:"language,omitempty"`
+	Translated    bool      `json:"translated,omitempty"`
+	Segments      []Segment `json:"segments,omitempty"`
+	TranscribedAt string    `json:"transcribed_at"`
+}
+
+// Segment is a single word or phrase-level chunk of a transcription,
+// used to drive transcript-to-audio seeking in the memo UI.
+type Segment struct {
+	Start      float64 `json:"start"`
+	End        float64 `json:"end"`
+	Text       string  `json:"text"`
+	Confidence float64 `json:"confidence,omitempty"`
+}
+
+// TranscribeOptions controls optional behavior supported by some
+// providers. Providers that don't implement OptionsTranscriber ignore
+// these and always return a plain transcription.
+type TranscribeOptions struct {
+	// Translate requests an English translation instead of a
+	// transcription in the source language.
+	Translate bool
+	// WordTimestamps requests per-segment timing so the result can
+	// drive transcript-to-audio seeking.
+	WordTimestamps bool
+}
+
+// OptionsTranscriber is implemented by providers that support
+// translation and/or word-level timestamps. The manager falls back to
+// the plain Transcribe method for providers that don't implement it.
+type OptionsTranscriber interface {
+	TranscribeWithOptions(audioPath string, opts TranscribeOptions) (*TranscriptionResult, error)
 }
 
 // ============================================================================
@@ -134,6 +165,100 @@ func (w *WhisperCppProvider) Transcribe(audioPath string) (string, error) {
 	return strings.TrimSpace(string(text)), nil
 }
 
+// whisperCppJSON mirrors the structure whisper.cpp writes with -oj.
+type whisperCppJSON struct {
+	Transcription []struct {
+		Offsets struct {
+			From float64 `json:"from"`
+			To   float64 `json:"to"`
+		} `json:"offsets"`
+		Text string `json:"text"`
+	} `json:"transcription"`
+}
+
+// TranscribeWithOptions passes -tr through for translation. When
+// WordTimestamps is set it passes -oj to get JSON output with per-segment
+// timings instead of the flat .txt file; otherwise it reads the .txt file.
+func (w *WhisperCppProvider) TranscribeWithOptions(audioPath string, opts TranscribeOptions) (*TranscriptionResult, error) {
+	if !w.IsAvailable() {
+		return nil, fmt.Errorf("whisper.cpp not found in PATH")
+	}
+
+	args := []string{"-f", audioPath}
+
+	if w.modelPath != "" {
+		args = append(args, "-m", w.modelPath)
+	}
+	args = append(args, "-l", w.language)
+
+	if opts.Translate {
+		args = append(args, "-tr")
+	}
+
+	if !opts.WordTimestamps {
+		// No segment timing requested - the plain text path is cheaper,
+		// but it still needs -tr threaded through so a translate request
+		// doesn't silently come back as a same-language transcription.
+		textArgs := append(append([]string{}, args...), "-otxt")
+
+		cmd := exec.Command(w.execPath, textArgs...)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return nil, fmt.Errorf("whisper.cpp failed: %v\nOutput: %s", err, output)
+		}
+
+		txtFile := strings.TrimSuffix(audioPath, filepath.Ext(audioPath)) + ".txt"
+		text, err := os.ReadFile(txtFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read transcription: %v", err)
+		}
+		os.Remove(txtFile)
+
+		return &TranscriptionResult{Text: strings.TrimSpace(string(text)), Translated: opts.Translate}, nil
+	}
+
+	args = append(args, "-oj")
+
+	cmd := exec.Command(w.execPath, args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("whisper.cpp failed: %v\nOutput: %s", err, output)
+	}
+
+	jsonFile := strings.TrimSuffix(audioPath, filepath.Ext(audioPath)) + ".json"
+	data, err := os.ReadFile(jsonFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transcription: %v", err)
+	}
+	os.Remove(jsonFile)
+
+	var parsed whisperCppJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse whisper.cpp JSON output: %v", err)
+	}
+
+	var segments []Segment
+	var fullText strings.Builder
+	for _, seg := range parsed.Transcription {
+		text := strings.TrimSpace(seg.Text)
+		segments = append(segments, Segment{
+			Start: seg.Offsets.From / 1000,
+			End:   seg.Offsets.To / 1000,
+			Text:  text,
+		})
+		if fullText.Len() > 0 {
+			fullText.WriteString(" ")
+		}
+		fullText.WriteString(text)
+	}
+
+	return &TranscriptionResult{
+		Text:       fullText.String(),
+		Segments:   segments,
+		Translated: opts.Translate,
+	}, nil
+}
+
 // ============================================================================
 // VOSK PROVIDER (External Command)
 // ============================================================================
@@ -382,20 +507,133 @@ except Exception as e:
 	return strings.TrimSpace(string(output)), nil
 }
 
+// openaiVerboseJSON mirrors the subset of OpenAI's verbose_json response
+// format we care about.
+type openaiVerboseJSON struct {
+	Text     string `json:"text"`
+	Language string `json:"language"`
+	Segments []struct {
+		Start float64 `json:"start"`
+		End   float64 `json:"end"`
+		Text  string  `json:"text"`
+	} `json:"segments"`
+}
+
+// TranscribeWithOptions uses the translations endpoint when Translate is
+// set, and response_format=verbose_json to recover segment timings when
+// WordTimestamps is set.
+func (o *OpenAIWhisperProvider) TranscribeWithOptions(audioPath string, opts TranscribeOptions) (*TranscriptionResult, error) {
+	if !o.IsAvailable() {
+		return nil, fmt.Errorf("OpenAI Whisper API not configured")
+	}
+
+	endpoint := "transcriptions"
+	if opts.Translate {
+		endpoint = "translations"
+	}
+
+	responseFormat := "text"
+	if opts.WordTimestamps {
+		responseFormat = "verbose_json"
+	}
+
+	script := `
+import openai
+import sys
+import os
+
+api_key = os.getenv('OPENAI_API_KEY')
+if not api_key:
+	api_key = '` + o.apiKey + `'
+
+client = openai.OpenAI(api_key=api_key)
+
+try:
+	with open(sys.argv[1], 'rb') as audio_file:
+		transcript = client.audio.` + endpoint + `.create(
+			model="whisper-1",
+			file=audio_file,
+			response_format="` + responseFormat + `"
+		)
+	if "` + responseFormat + `" == "verbose_json":
+		print(transcript.model_dump_json())
+	else:
+		print(transcript if isinstance(transcript, str) else transcript.text)
+except Exception as e:
+	print(f"Error: {e}", file=sys.stderr)
+	sys.exit(1)
+`
+
+	tempScript := filepath.Join(os.TempDir(), "voicelog_openai_transcribe.py")
+	if err := os.WriteFile(tempScript, []byte(script), 0600); err != nil {
+		return nil, fmt.Errorf("failed to create temp script: %v", err)
+	}
+	defer os.Remove(tempScript)
+
+	var pythonCmd string
+	for _, cmd := range []string{"python3", "python", "py"} {
+		if _, err := exec.LookPath(cmd); err == nil {
+			pythonCmd = cmd
+			break
+		}
+	}
+	if pythonCmd == "" {
+		return nil, fmt.Errorf("no python interpreter found")
+	}
+
+	env := os.Environ()
+	if o.apiKey != "" {
+		env = append(env, "OPENAI_API_KEY="+o.apiKey)
+	}
+
+	cmd := exec.Command(pythonCmd, tempScript, audioPath)
+	cmd.Env = env
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("OpenAI Whisper API failed: %v\nOutput: %s", err, output)
+	}
+
+	if !opts.WordTimestamps {
+		return &TranscriptionResult{
+			Text:       strings.TrimSpace(string(output)),
+			Translated: opts.Translate,
+		}, nil
+	}
+
+	var parsed openaiVerboseJSON
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAI verbose_json response: %v", err)
+	}
+
+	segments := make([]Segment, 0, len(parsed.Segments))
+	for _, seg := range parsed.Segments {
+		segments = append(segments, Segment{Start: seg.Start, End: seg.End, Text: strings.TrimSpace(seg.Text)})
+	}
+
+	return &TranscriptionResult{
+		Text:       parsed.Text,
+		Language:   parsed.Language,
+		Segments:   segments,
+		Translated: opts.Translate,
+	}, nil
+}
+
 // ============================================================================
 // TRANSCRIPTION MANAGER
 // ============================================================================
 
 type TranscriptionManager struct {
-	providers map[string]TranscriptionProvider
-	config    TranscriptionConfig
-	configDir string
+	providers          map[string]TranscriptionProvider
+	streamingProviders map[string]StreamingTranscriptionProvider
+	config             TranscriptionConfig
+	configDir          string
 }
 
 func NewTranscriptionManager(configDir string) *TranscriptionManager {
 	tm := &TranscriptionManager{
-		providers: make(map[string]TranscriptionProvider),
-		configDir: configDir,
+		providers:          make(map[string]TranscriptionProvider),
+		streamingProviders: make(map[string]StreamingTranscriptionProvider),
+		configDir:          configDir,
 		config: TranscriptionConfig{
 			Enabled:         false,
 			DefaultProvider: "",
@@ -409,6 +647,15 @@ func NewTranscriptionManager(configDir string) *TranscriptionManager {
 	tm.RegisterProvider(NewVoskProvider())
 	tm.RegisterProvider(NewPythonScriptProvider())
 	tm.RegisterProvider(NewOpenAIWhisperProvider())
+	tm.RegisterProvider(NewWhisperNativeProvider())
+
+	// Register any external backends dropped into providers/ inside the
+	// config directory (e.g. Vosk, Faster-Whisper, Parakeet wrappers).
+	for _, provider := range DiscoverGRPCProviders(filepath.Join(configDir, "providers")) {
+		tm.RegisterProvider(provider)
+	}
+
+	tm.RegisterStreamingProvider(NewWhisperStreamProvider())
 
 	// Load config
 	tm.LoadConfig()
@@ -427,6 +674,62 @@ func (tm *TranscriptionManager) RegisterProvider(provider TranscriptionProvider)
 	tm.providers[provider.Name()] = provider
 }
 
+func (tm *TranscriptionManager) RegisterStreamingProvider(provider StreamingTranscriptionProvider) {
+	tm.streamingProviders[provider.Name()] = provider
+}
+
+// closer is implemented by providers holding a resource that must be
+// released on shutdown - a GRPCProvider's spawned child process and
+// socket, or a WhisperNativeProvider's loaded model context.
+type closer interface {
+	Close() error
+}
+
+// Close releases every registered provider's resources (spawned
+// external-backend processes, loaded native model contexts, etc). It
+// should be called once on application shutdown.
+func (tm *TranscriptionManager) Close() error {
+	var firstErr error
+
+	for _, provider := range tm.providers {
+		if c, ok := provider.(closer); ok {
+			if err := c.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	for _, provider := range tm.streamingProviders {
+		if c, ok := provider.(closer); ok {
+			if err := c.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// StartStream begins a streaming transcription on providerName (or the
+// default provider, if it supports streaming). The caller owns audio and
+// should close it once the memo stops recording; the manager finalizes
+// the last in-progress segment before closing the returned channel.
+func (tm *TranscriptionManager) StartStream(ctx context.Context, providerName string, audio <-chan []int16) (<-chan PartialResult, error) {
+	if providerName == "" {
+		providerName = tm.config.DefaultProvider
+	}
+
+	provider, ok := tm.streamingProviders[providerName]
+	if !ok {
+		return nil, fmt.Errorf("streaming provider not found: %s", providerName)
+	}
+	if !provider.IsAvailable() {
+		return nil, fmt.Errorf("streaming provider not available: %s", providerName)
+	}
+
+	return provider.TranscribeStream(ctx, audio), nil
+}
+
 func (tm *TranscriptionManager) GetAvailableProviders() []string {
 	var available []string
 	for name, provider := range tm.providers {
@@ -453,6 +756,14 @@ func (tm *TranscriptionManager) IsProviderAvailable(name string) bool {
 }
 
 func (tm *TranscriptionManager) Transcribe(audioPath string, providerName string) (*TranscriptionResult, error) {
+	return tm.TranscribeWithOptions(audioPath, providerName, TranscribeOptions{})
+}
+
+// TranscribeWithOptions transcribes audioPath using providerName (or the
+// default provider). Providers implementing OptionsTranscriber honor
+// opts.Translate and opts.WordTimestamps; other providers ignore opts and
+// return a plain transcription.
+func (tm *TranscriptionManager) TranscribeWithOptions(audioPath string, providerName string, opts TranscribeOptions) (*TranscriptionResult, error) {
 	if !tm.config.Enabled {
 		return nil, fmt.Errorf("transcription is disabled")
 	}
@@ -475,17 +786,25 @@ func (tm *TranscriptionManager) Transcribe(audioPath string, providerName string
 		return nil, fmt.Errorf("provider not available: %s", providerName)
 	}
 
-	text, err := provider.Transcribe(audioPath)
-	if err != nil {
-		return nil, err
-	}
+	var result *TranscriptionResult
 
-	result := &TranscriptionResult{
-		Text:          text,
-		Provider:      providerName,
-		TranscribedAt: time.Now().Format(time.RFC3339),
+	if optionsProvider, ok := provider.(OptionsTranscriber); ok {
+		r, err := optionsProvider.TranscribeWithOptions(audioPath, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = r
+	} else {
+		text, err := provider.Transcribe(audioPath)
+		if err != nil {
+			return nil, err
+		}
+		result = &TranscriptionResult{Text: text}
 	}
 
+	result.Provider = providerName
+	result.TranscribedAt = time.Now().Format(time.RFC3339)
+
 	return result, nil
 }
 
@@ -592,6 +911,12 @@ func ShowTranscriptionSetupInstructions() {
 	fmt.Println("   - Script should accept audio file path and output text")
 	fmt.Println("   - Example template available in documentation")
 	fmt.Println()
+	fmt.Println("5. External gRPC Backend (Advanced)")
+	fmt.Println("   - Drop an executable into the providers/ directory next to your config")
+	fmt.Println("   - VoiceLog spawns it and connects over a UNIX socket, or you can")
+	fmt.Println("     point it at an already-running backend via the 'address' config key")
+	fmt.Println("   - See proto/backend.proto for the service definition")
+	fmt.Println()
 	fmt.Println("Configuration:")
 	fmt.Println("   Press Ctrl+S -> Navigate to 'Transcription Settings'")
 	fmt.Println("   Enable transcription and select your provider")
@@ -0,0 +1,480 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// VOICEBOT INTERFACES
+// ============================================================================
+
+// ChatMessage is a single turn in a Conversation.
+type ChatMessage struct {
+	Role    string `json:"role"` // "system", "user", or "assistant"
+	Content string `json:"content"`
+}
+
+// LLMProvider represents a plugin interface for chat-completion backends,
+// mirroring TranscriptionProvider's shape.
+type LLMProvider interface {
+	Name() string
+	IsAvailable() bool
+	Chat(messages []ChatMessage) (string, error)
+	Configure(config map[string]string) error
+}
+
+// SynthesisProvider represents a plugin interface for text-to-speech
+// backends. Synthesize writes the rendered audio to a temp file and
+// returns its path, matching how TranscriptionProvider deals in file
+// paths rather than in-memory buffers.
+type SynthesisProvider interface {
+	Name() string
+	IsAvailable() bool
+	Synthesize(text string) (audioPath string, err error)
+	Configure(config map[string]string) error
+}
+
+// Conversation holds the rolling message history for one voicebot
+// session so past exchanges can be replayed.
+type Conversation struct {
+	ID        string        `json:"id"`
+	MemoID    string        `json:"memo_id,omitempty"`
+	Messages  []ChatMessage `json:"messages"`
+	CreatedAt string        `json:"created_at"`
+	UpdatedAt string        `json:"updated_at"`
+}
+
+// VoicebotConfig holds voicebot settings.
+type VoicebotConfig struct {
+	Enabled            bool                         `json:"enabled"`
+	SystemPrompt       string                       `json:"system_prompt"`
+	DefaultLLM         string                       `json:"default_llm"`
+	DefaultSynthesis   string                       `json:"default_synthesis"`
+	TranscriptionName  string                       `json:"transcription_provider,omitempty"`
+	ActiveConversation string                       `json:"active_conversation,omitempty"`
+	ProviderConfigs    map[string]map[string]string `json:"provider_configs"`
+}
+
+// ============================================================================
+// OPENAI-COMPATIBLE PROVIDER (OpenAI, LocalAI, Ollama's OpenAI shim, ...)
+// ============================================================================
+
+// OpenAICompatibleProvider talks to any server implementing the OpenAI
+// chat-completions and audio-speech REST APIs. Pointing base_url at
+// LocalAI or Ollama's OpenAI-compatible endpoint works without code
+// changes, matching how VoiceLog already lets whisper.cpp/Vosk/OpenAI
+// sit behind the same TranscriptionProvider interface.
+type OpenAICompatibleProvider struct {
+	baseURL string
+	apiKey  string
+	model   string
+	voice   string
+	client  *http.Client
+}
+
+func NewOpenAICompatibleProvider() *OpenAICompatibleProvider {
+	return &OpenAICompatibleProvider{
+		baseURL: "https://api.openai.com/v1",
+		model:   "gpt-4o-mini",
+		voice:   "alloy",
+		client:  &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+func (o *OpenAICompatibleProvider) Name() string {
+	return "openai_compatible"
+}
+
+func (o *OpenAICompatibleProvider) IsAvailable() bool {
+	return o.baseURL != ""
+}
+
+func (o *OpenAICompatibleProvider) Configure(config map[string]string) error {
+	if url, ok := config["base_url"]; ok && url != "" {
+		o.baseURL = strings.TrimSuffix(url, "/")
+	}
+	if key, ok := config["api_key"]; ok {
+		o.apiKey = key
+	}
+	if model, ok := config["model"]; ok && model != "" {
+		o.model = model
+	}
+	if voice, ok := config["voice"]; ok && voice != "" {
+		o.voice = voice
+	}
+	return nil
+}
+
+func (o *OpenAICompatibleProvider) authHeader(req *http.Request) {
+	key := o.apiKey
+	if key == "" {
+		key = os.Getenv("OPENAI_API_KEY")
+	}
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+}
+
+type chatCompletionRequest struct {
+	Model    string        `json:"model"`
+	Messages []ChatMessage `json:"messages"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message ChatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// Chat sends the full rolling message history to POST {base_url}/chat/completions.
+func (o *OpenAICompatibleProvider) Chat(messages []ChatMessage) (string, error) {
+	if !o.IsAvailable() {
+		return "", fmt.Errorf("openai_compatible: base_url not configured")
+	}
+
+	body, err := json.Marshal(chatCompletionRequest{Model: o.model, Messages: messages})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, o.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	o.authHeader(req)
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("chat completion request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("chat completion failed: %s", resp.Status)
+	}
+
+	var parsed chatCompletionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("failed to decode chat completion response: %v", err)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("chat completion returned no choices")
+	}
+
+	return strings.TrimSpace(parsed.Choices[0].Message.Content), nil
+}
+
+type speechRequest struct {
+	Model string `json:"model"`
+	Input string `json:"input"`
+	Voice string `json:"voice"`
+}
+
+// Synthesize calls POST {base_url}/audio/speech and writes the returned
+// audio bytes to a temp file, the same file-in/file-out shape
+// TranscriptionProvider uses.
+func (o *OpenAICompatibleProvider) Synthesize(text string) (string, error) {
+	if !o.IsAvailable() {
+		return "", fmt.Errorf("openai_compatible: base_url not configured")
+	}
+
+	body, err := json.Marshal(speechRequest{Model: "tts-1", Input: text, Voice: o.voice})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, o.baseURL+"/audio/speech", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	o.authHeader(req)
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("speech synthesis request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("speech synthesis failed: %s", resp.Status)
+	}
+
+	outPath := filepath.Join(os.TempDir(), fmt.Sprintf("voicelog_reply_%d.mp3", time.Now().UnixNano()))
+	out, err := os.Create(outPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create reply audio file: %v", err)
+	}
+	defer out.Close()
+
+	if _, err := out.ReadFrom(resp.Body); err != nil {
+		return "", fmt.Errorf("failed to write reply audio: %v", err)
+	}
+
+	return outPath, nil
+}
+
+// ============================================================================
+// VOICEBOT MANAGER
+// ============================================================================
+
+// VoicebotManager turns VoiceLog from a passive recorder into an
+// interactive assistant: it transcribes a memo, sends the text to a chat
+// model alongside the rolling conversation history, and synthesizes the
+// reply, mirroring how TranscriptionManager wires providers together.
+type VoicebotManager struct {
+	llmProviders       map[string]LLMProvider
+	synthesisProviders map[string]SynthesisProvider
+	config             VoicebotConfig
+	configDir          string
+}
+
+func NewVoicebotManager(configDir string) *VoicebotManager {
+	vm := &VoicebotManager{
+		llmProviders:       make(map[string]LLMProvider),
+		synthesisProviders: make(map[string]SynthesisProvider),
+		configDir:          configDir,
+		config: VoicebotConfig{
+			Enabled:         false,
+			ProviderConfigs: make(map[string]map[string]string),
+		},
+	}
+
+	openai := NewOpenAICompatibleProvider()
+	vm.RegisterLLMProvider(openai)
+	vm.RegisterSynthesisProvider(openai)
+
+	vm.LoadConfig()
+
+	for name, provider := range vm.llmProviders {
+		if cfg, ok := vm.config.ProviderConfigs[name]; ok {
+			provider.Configure(cfg)
+		}
+	}
+	for name, provider := range vm.synthesisProviders {
+		if cfg, ok := vm.config.ProviderConfigs[name]; ok {
+			provider.Configure(cfg)
+		}
+	}
+
+	return vm
+}
+
+func (vm *VoicebotManager) RegisterLLMProvider(provider LLMProvider) {
+	vm.llmProviders[provider.Name()] = provider
+}
+
+func (vm *VoicebotManager) RegisterSynthesisProvider(provider SynthesisProvider) {
+	vm.synthesisProviders[provider.Name()] = provider
+}
+
+func (vm *VoicebotManager) LoadConfig() error {
+	configPath := filepath.Join(vm.configDir, "voicebot.json")
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	return json.Unmarshal(data, &vm.config)
+}
+
+func (vm *VoicebotManager) SaveConfig() error {
+	configPath := filepath.Join(vm.configDir, "voicebot.json")
+
+	data, err := json.MarshalIndent(vm.config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(configPath, data, 0644)
+}
+
+// NewConversation starts a conversation seeded with the configured system
+// prompt, associated with memoID for replay.
+func (vm *VoicebotManager) NewConversation(memoID string) *Conversation {
+	now := time.Now().Format(time.RFC3339)
+	conv := &Conversation{
+		ID:        fmt.Sprintf("conv_%d", time.Now().UnixNano()),
+		MemoID:    memoID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if vm.config.SystemPrompt != "" {
+		conv.Messages = append(conv.Messages, ChatMessage{Role: "system", Content: vm.config.SystemPrompt})
+	}
+	return conv
+}
+
+// RunTurn appends userText to conv, sends the rolling history to the
+// default LLM provider, appends the reply, synthesizes it with the
+// default synthesis provider, and persists conv alongside the memo. It
+// returns the reply text and the path to the synthesized reply audio.
+func (vm *VoicebotManager) RunTurn(conv *Conversation, userText string) (replyText string, replyAudioPath string, err error) {
+	if !vm.config.Enabled {
+		return "", "", fmt.Errorf("voicebot is disabled")
+	}
+
+	llmName := vm.config.DefaultLLM
+	llm, ok := vm.llmProviders[llmName]
+	if !ok {
+		return "", "", fmt.Errorf("LLM provider not found: %s", llmName)
+	}
+	if !llm.IsAvailable() {
+		return "", "", fmt.Errorf("LLM provider not available: %s", llmName)
+	}
+
+	synthName := vm.config.DefaultSynthesis
+	synth, ok := vm.synthesisProviders[synthName]
+	if !ok {
+		return "", "", fmt.Errorf("synthesis provider not found: %s", synthName)
+	}
+	if !synth.IsAvailable() {
+		return "", "", fmt.Errorf("synthesis provider not available: %s", synthName)
+	}
+
+	conv.Messages = append(conv.Messages, ChatMessage{Role: "user", Content: userText})
+
+	reply, err := llm.Chat(conv.Messages)
+	if err != nil {
+		return "", "", fmt.Errorf("chat failed: %v", err)
+	}
+	conv.Messages = append(conv.Messages, ChatMessage{Role: "assistant", Content: reply})
+	conv.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	audioPath, err := synth.Synthesize(reply)
+	if err != nil {
+		return reply, "", fmt.Errorf("synthesis failed: %v", err)
+	}
+
+	if err := vm.SaveConversation(conv); err != nil {
+		return reply, audioPath, fmt.Errorf("failed to persist conversation: %v", err)
+	}
+
+	return reply, audioPath, nil
+}
+
+// HandleMemo is the Ctrl+V entry point: it transcribes audioPath with tm
+// (using vm.config.TranscriptionName, or tm's own default provider if
+// unset) and runs the result through RunTurn - the full STT -> LLM -> TTS
+// loop for one recorded memo. Successive memos continue the same rolling
+// conversation (vm.config.ActiveConversation) until StartNewConversation
+// is called, rather than each press starting an isolated one-turn chat.
+func (vm *VoicebotManager) HandleMemo(audioPath string, memoID string, tm *TranscriptionManager) (replyText string, replyAudioPath string, err error) {
+	if !vm.config.Enabled {
+		return "", "", fmt.Errorf("voicebot is disabled")
+	}
+
+	result, err := tm.Transcribe(audioPath, vm.config.TranscriptionName)
+	if err != nil {
+		return "", "", fmt.Errorf("transcription failed: %v", err)
+	}
+
+	conv := vm.currentConversation(memoID)
+
+	replyText, replyAudioPath, err = vm.RunTurn(conv, result.Text)
+	if err != nil {
+		return replyText, replyAudioPath, err
+	}
+
+	vm.config.ActiveConversation = conv.ID
+	if saveErr := vm.SaveConfig(); saveErr != nil {
+		return replyText, replyAudioPath, fmt.Errorf("failed to persist active conversation: %v", saveErr)
+	}
+
+	return replyText, replyAudioPath, nil
+}
+
+// currentConversation returns the active conversation to continue, or
+// starts a fresh one (tagged with memoID) if none is active yet or it
+// can no longer be loaded.
+func (vm *VoicebotManager) currentConversation(memoID string) *Conversation {
+	if vm.config.ActiveConversation != "" {
+		if conv, err := vm.LoadConversation(vm.config.ActiveConversation); err == nil {
+			return conv
+		}
+	}
+	return vm.NewConversation(memoID)
+}
+
+// StartNewConversation clears the active conversation so the next
+// HandleMemo call begins a fresh exchange instead of continuing the
+// previous one.
+func (vm *VoicebotManager) StartNewConversation() error {
+	vm.config.ActiveConversation = ""
+	return vm.SaveConfig()
+}
+
+// conversationsDir returns (and creates) the directory conversations are
+// persisted to, alongside the memo store.
+func (vm *VoicebotManager) conversationsDir() (string, error) {
+	dir := filepath.Join(vm.configDir, "conversations")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// SaveConversation persists conv so it can be replayed later.
+func (vm *VoicebotManager) SaveConversation(conv *Conversation) error {
+	dir, err := vm.conversationsDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(conv, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, conv.ID+".json"), data, 0644)
+}
+
+// LoadConversation loads a previously persisted conversation by ID so it
+// can be replayed or continued.
+func (vm *VoicebotManager) LoadConversation(id string) (*Conversation, error) {
+	dir, err := vm.conversationsDir()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, id+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("conversation not found: %s", id)
+	}
+
+	var conv Conversation
+	if err := json.Unmarshal(data, &conv); err != nil {
+		return nil, fmt.Errorf("failed to parse conversation: %v", err)
+	}
+
+	return &conv, nil
+}
+
+// SetEnabled enables or disables the voicebot mode.
+func (vm *VoicebotManager) SetEnabled(enabled bool) error {
+	vm.config.Enabled = enabled
+	return vm.SaveConfig()
+}
+
+// SetSystemPrompt sets the system prompt new conversations are seeded with.
+func (vm *VoicebotManager) SetSystemPrompt(prompt string) error {
+	vm.config.SystemPrompt = prompt
+	return vm.SaveConfig()
+}
+
+// GetConfig returns the current voicebot configuration.
+func (vm *VoicebotManager) GetConfig() VoicebotConfig {
+	return vm.config
+}
@@ -0,0 +1,176 @@
+//go:build whisper
+
+package main
+
+/*
+#cgo LDFLAGS: -lwhisper -lm -lstdc++
+#include <whisper.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"unsafe"
+)
+
+// ============================================================================
+// WHISPER NATIVE PROVIDER (cgo binding, no fork/exec per memo)
+// ============================================================================
+
+// WhisperNativeProvider links against whisper.cpp via cgo, behind the
+// "whisper" build tag (the same pattern alsa_silence_linux.go uses for
+// ALSA on Linux), and calls whisper_full directly on decoded PCM. This
+// eliminates the fork/exec + tempfile round-trip WhisperCppProvider pays
+// for every memo.
+type WhisperNativeProvider struct {
+	modelPath string
+	language  string
+	threads   int
+	translate bool
+	beamSize  int
+
+	ctx *C.struct_whisper_context
+}
+
+func NewWhisperNativeProvider() *WhisperNativeProvider {
+	return &WhisperNativeProvider{
+		language: "en",
+		threads:  4,
+		beamSize: 5,
+	}
+}
+
+func (w *WhisperNativeProvider) Name() string {
+	return "whisper_native"
+}
+
+func (w *WhisperNativeProvider) IsAvailable() bool {
+	return w.modelPath != ""
+}
+
+func (w *WhisperNativeProvider) Configure(config map[string]string) error {
+	if path, ok := config["model_path"]; ok {
+		w.modelPath = path
+	}
+	if lang, ok := config["language"]; ok {
+		w.language = lang
+	}
+	if threads, ok := config["threads"]; ok {
+		if n, err := strconv.Atoi(threads); err == nil {
+			w.threads = n
+		}
+	}
+	if translate, ok := config["translate"]; ok {
+		w.translate = translate == "true" || translate == "1"
+	}
+	if beamSize, ok := config["beam_size"]; ok {
+		if n, err := strconv.Atoi(beamSize); err == nil {
+			w.beamSize = n
+		}
+	}
+	return nil
+}
+
+// ensureModelLoaded lazily loads the GGML model on first use.
+func (w *WhisperNativeProvider) ensureModelLoaded() error {
+	if w.ctx != nil {
+		return nil
+	}
+	if w.modelPath == "" {
+		return fmt.Errorf("whisper_native: model_path not configured")
+	}
+
+	cPath := C.CString(w.modelPath)
+	defer C.free(unsafe.Pointer(cPath))
+
+	ctx := C.whisper_init_from_file(cPath)
+	if ctx == nil {
+		return fmt.Errorf("whisper_native: failed to load model %s", w.modelPath)
+	}
+	w.ctx = ctx
+	return nil
+}
+
+func (w *WhisperNativeProvider) Transcribe(audioPath string) (string, error) {
+	if err := w.ensureModelLoaded(); err != nil {
+		return "", err
+	}
+
+	samples, err := decodeToFloat32Mono16kHz(audioPath)
+	if err != nil {
+		return "", fmt.Errorf("whisper_native: %v", err)
+	}
+	if len(samples) == 0 {
+		return "", fmt.Errorf("whisper_native: %s decoded to no audio samples", audioPath)
+	}
+
+	// whisper.cpp only consults beam_search.beam_size under the
+	// beam-search strategy; greedy decoding ignores it entirely.
+	strategy := C.WHISPER_SAMPLING_GREEDY
+	if w.beamSize > 0 {
+		strategy = C.WHISPER_SAMPLING_BEAM_SEARCH
+	}
+
+	params := C.whisper_full_default_params(strategy)
+	params.n_threads = C.int(w.threads)
+	params.translate = C.bool(w.translate)
+	params.beam_search.beam_size = C.int(w.beamSize)
+
+	cLang := C.CString(w.language)
+	defer C.free(unsafe.Pointer(cLang))
+	params.language = cLang
+
+	if C.whisper_full(w.ctx, params, (*C.float)(unsafe.Pointer(&samples[0])), C.int(len(samples))) != 0 {
+		return "", fmt.Errorf("whisper_native: whisper_full failed")
+	}
+
+	numSegments := int(C.whisper_full_n_segments(w.ctx))
+	text := ""
+	for i := 0; i < numSegments; i++ {
+		text += C.GoString(C.whisper_full_get_segment_text(w.ctx, C.int(i)))
+	}
+
+	return text, nil
+}
+
+// Close releases the underlying whisper.cpp context. It implements the
+// same io.Closer-shaped method TranscriptionManager.Close looks for on
+// every registered provider.
+func (w *WhisperNativeProvider) Close() error {
+	if w.ctx != nil {
+		C.whisper_free(w.ctx)
+		w.ctx = nil
+	}
+	return nil
+}
+
+// decodeToFloat32Mono16kHz shells out to ffmpeg (already a soft
+// dependency for memo playback elsewhere in VoiceLog) to decode any
+// input audio format into the float32 mono 16kHz PCM whisper_full
+// expects, avoiding a tempfile round-trip by reading its stdout.
+func decodeToFloat32Mono16kHz(audioPath string) ([]float32, error) {
+	cmd := exec.Command("ffmpeg",
+		"-i", audioPath,
+		"-f", "f32le",
+		"-ac", "1",
+		"-ar", "16000",
+		"-loglevel", "error",
+		"pipe:1",
+	)
+
+	raw, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode audio with ffmpeg: %v", err)
+	}
+
+	samples := make([]float32, len(raw)/4)
+	for i := range samples {
+		bits := uint32(raw[i*4]) | uint32(raw[i*4+1])<<8 | uint32(raw[i*4+2])<<16 | uint32(raw[i*4+3])<<24
+		samples[i] = *(*float32)(unsafe.Pointer(&bits))
+	}
+
+	return samples, nil
+}
@@ -0,0 +1,27 @@
+//go:build !whisper
+
+package main
+
+// WhisperNativeProvider is the degraded stand-in used when VoiceLog is
+// built without the "whisper" tag (i.e. without whisper.cpp available to
+// link against via cgo). It forwards every call except Name and Close to
+// WhisperCppProvider so callers can depend on WhisperNativeProvider
+// existing either way.
+type WhisperNativeProvider struct {
+	*WhisperCppProvider
+}
+
+func NewWhisperNativeProvider() *WhisperNativeProvider {
+	return &WhisperNativeProvider{WhisperCppProvider: NewWhisperCppProvider()}
+}
+
+func (w *WhisperNativeProvider) Name() string {
+	return "whisper_native"
+}
+
+// Close is a no-op here - there's no native context to free when
+// falling back to WhisperCppProvider - but it keeps WhisperNativeProvider
+// satisfying the same closer shape as the cgo-backed implementation.
+func (w *WhisperNativeProvider) Close() error {
+	return nil
+}